@@ -0,0 +1,49 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package modedit applies edits to go.mod files (requirements,
+// exclusions, replacements, and ceilings) exactly as vgo does,
+// writing each file atomically, so that external tools can script
+// go.mod modifications without running 'vgo mod edit'.
+//
+// The package is a thin wrapper around vgo's cmd/go/modedit;
+// see EditGoMod for the restrictions that come from sharing the loader.
+package modedit
+
+import "cmd/go/modedit"
+
+type (
+	// An Op is the kind of operation performed by an Edit.
+	Op = modedit.Op
+
+	// A Version is a module path and version.
+	Version = modedit.Version
+
+	// An Edit is a single editing operation on a go.mod file.
+	Edit = modedit.Edit
+)
+
+const (
+	Require     = modedit.Require     // require Mod.Path at Mod.Version
+	DropRequire = modedit.DropRequire // drop any requirement on Mod.Path
+	Exclude     = modedit.Exclude     // exclude Mod
+	DropExclude = modedit.DropExclude // drop the exclusion of Mod
+	Replace     = modedit.Replace     // replace Mod (all versions if Mod.Version is empty) with New
+	DropReplace = modedit.DropReplace // drop the replacement of Mod
+	Ceiling     = modedit.Ceiling     // never select Mod.Path at or above Mod.Version
+	DropCeiling = modedit.DropCeiling // drop the ceiling for Mod.Path
+)
+
+// EditGoMod applies the edits, in order, to the go.mod file named by file,
+// as 'vgo mod edit' does. The file is written atomically: if any edit
+// fails, EditGoMod returns an error and leaves the file unchanged.
+//
+// A required version may be a module query, such as "latest" or "v1.2".
+// Resolving it uses vgo's module loader, which keeps its state in global
+// variables and is configured by the same environment variables as vgo,
+// such as GOPROXY and GOFLAGS; errors that vgo treats as fatal are
+// printed to standard error and end the program.
+func EditGoMod(file string, edits []Edit) error {
+	return modedit.EditGoMod(file, edits)
+}
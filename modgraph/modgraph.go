@@ -0,0 +1,46 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package modgraph provides the module requirement graph of the main
+// module (nodes, edges, selected versions, and replacements) as Go data
+// structures, computed by vgo's own module loader, so that
+// dependency-analysis tools can embed vgo rather than running
+// 'vgo mod graph' and parsing its output.
+//
+// The package is a thin wrapper around vgo's cmd/go/modgraph;
+// see Load for the restrictions that come from sharing the loader.
+package modgraph
+
+import "cmd/go/modgraph"
+
+type (
+	// A Version is a module path and version.
+	// The main module has an empty Version.
+	Version = modgraph.Version
+
+	// A Graph is the module requirement graph of the main module,
+	// with replacements and exclusions applied.
+	Graph = modgraph.Graph
+
+	// A Node is a single module version in a Graph.
+	Node = modgraph.Node
+
+	// An Edge records that module From requires module To.
+	Edge = modgraph.Edge
+)
+
+// Load returns the requirement graph of the main module, the module
+// containing the current directory, as 'vgo mod graph' computes it.
+// Unlike vgo, Load never updates go.mod.
+//
+// Load uses vgo's module loader, which keeps its state in global
+// variables and is configured by the same environment variables as vgo,
+// such as GOPROXY and GOFLAGS. A program can therefore call Load only
+// once, and errors that vgo treats as fatal, such as a malformed go.mod
+// file, are printed to standard error and end the program.
+// Errors loading the requirements of individual module versions
+// are instead recorded in the graph's nodes.
+func Load() *Graph {
+	return modgraph.Load()
+}
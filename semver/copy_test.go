@@ -0,0 +1,31 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package semver
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+// TestCopy checks that this package is an exact copy of the go command's
+// internal semver package, so that tools using it compare versions
+// exactly as the go command does. Run update.bash to refresh the copy.
+func TestCopy(t *testing.T) {
+	for _, name := range []string{"semver.go", "semver_test.go"} {
+		want, err := ioutil.ReadFile(filepath.Join("../vendor/cmd/go/internal/semver", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		have, err := ioutil.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(have, want) {
+			t.Errorf("%s differs from the go command's copy; run update.bash", name)
+		}
+	}
+}
@@ -11,6 +11,7 @@ echo "package version; const version = \"$vers\"" > internal/version/vgo.go
 gofmt -w internal
 cd ../../..
 rm $(find . -name '*.orig')
+cp vendor/cmd/go/internal/semver/semver.go vendor/cmd/go/internal/semver/semver_test.go semver/
 go build
 ./vgo version
 rm vgo